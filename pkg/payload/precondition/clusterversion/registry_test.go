@@ -0,0 +1,38 @@
+package clusterversion
+
+import (
+	"testing"
+
+	"github.com/openshift/cluster-version-operator/pkg/payload/precondition"
+)
+
+// TestDefaultRegistryBuild exercises the init() registrations in this package end-to-end: it
+// confirms that precondition.DefaultRegistry.Build actually produces a runnable Upgradeable and
+// Rollback check, not just that Register was called.
+func TestDefaultRegistryBuild(t *testing.T) {
+	list := precondition.DefaultRegistry.Build(precondition.Dependencies{
+		ClusterVersionLister: fakeClusterVersionLister(t, nil),
+	})
+
+	names := map[string]precondition.Check{}
+	for _, check := range list {
+		names[check.Name()] = check
+	}
+
+	for _, name := range []string{"ClusterVersionUpgradeable", "ClusterVersionRollback"} {
+		check, ok := names[name]
+		if !ok {
+			t.Fatalf("expected DefaultRegistry.Build to include %s, got %v", name, names)
+		}
+		switch name {
+		case "ClusterVersionUpgradeable":
+			if _, ok := check.(*Upgradeable); !ok {
+				t.Errorf("expected %s to build an *Upgradeable, got %T", name, check)
+			}
+		case "ClusterVersionRollback":
+			if _, ok := check.(*Rollback); !ok {
+				t.Errorf("expected %s to build a *Rollback, got %T", name, check)
+			}
+		}
+	}
+}
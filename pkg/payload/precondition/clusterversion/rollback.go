@@ -0,0 +1,129 @@
+package clusterversion
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configv1listers "github.com/openshift/client-go/config/listers/config/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift/cluster-version-operator/lib/resourcemerge"
+	"github.com/openshift/cluster-version-operator/pkg/payload/precondition"
+)
+
+// RollbackAllowedConditionType is the ClusterOperatorStatusCondition Type that Rollback looks for
+// on ClusterVersion status to permit a patch-level downgrade. Status True means "Allowed".
+const RollbackAllowedConditionType = configv1.ClusterStatusConditionType("Rollback")
+
+// RollbackAllowedAnnotation is an annotation fallback for clusters whose ClusterVersion status does
+// not yet carry the Rollback condition, e.g. while running a CVO version that predates it.
+const RollbackAllowedAnnotation = "release.openshift.io/rollback-allowed"
+
+// Rollback checks if clusterversion permits downgrading to the proposed target version. It is the
+// downgrade-direction counterpart to Upgradeable: Upgradeable governs proposals that move the
+// cluster forward, Rollback governs proposals that move it backward.
+type Rollback struct {
+	key    string
+	lister configv1listers.ClusterVersionLister
+}
+
+// NewRollback returns a new Rollback precondition check.
+func NewRollback(lister configv1listers.ClusterVersionLister) *Rollback {
+	return &Rollback{
+		key:    "version",
+		lister: lister,
+	}
+}
+
+func init() {
+	precondition.DefaultRegistry.Register("ClusterVersionRollback", func(deps precondition.Dependencies) precondition.Check {
+		return NewRollback(deps.ClusterVersionLister)
+	})
+}
+
+// rollbackAllowed reports whether cv permits a patch-level downgrade, via either the Rollback
+// condition on status or the RollbackAllowedAnnotation fallback.
+func rollbackAllowed(cv *configv1.ClusterVersion) bool {
+	if cond := resourcemerge.FindOperatorStatusCondition(cv.Status.Conditions, RollbackAllowedConditionType); cond != nil {
+		return cond.Status == configv1.ConditionTrue
+	}
+	return cv.Annotations[RollbackAllowedAnnotation] == "Allowed"
+}
+
+// Run runs the Rollback precondition.
+// Apart from the errors resolveVersions returns when the ClusterVersion cannot be read or either
+// version cannot be resolved, it only has an opinion when the proposed target version is strictly
+// less than the current version; Upgradeable governs every other case, including a retarget to the
+// same version.
+func (pf *Rollback) Run(ctx context.Context, releaseContext precondition.ReleaseContext) error {
+	cv, currentVersion, targetVersion, err := resolveVersions(pf.lister, pf.key, pf.Name(), releaseContext)
+	if err != nil {
+		return err
+	}
+	if cv == nil {
+		return nil
+	}
+
+	if !targetVersion.LT(currentVersion) {
+		klog.V(4).Infof("Precondition %s passed: %s is not a downgrade from %s", pf.Name(), targetVersion, currentVersion)
+		return nil
+	}
+
+	// Cross-minor downgrades are forbidden unconditionally, even when the target happens to equal
+	// the previously completed version: "rollback in progress" only short-circuits same-minor
+	// retargets, which are the only kind a patch-level Rollback-allowed downgrade can produce.
+	if targetVersion.Major != currentVersion.Major || targetVersion.Minor != currentVersion.Minor {
+		return &precondition.Error{
+			Reason:  "CrossMinorDowngrade",
+			Message: fmt.Sprintf("Downgrading from %s to %s crosses a minor version, which is not supported", currentVersion, targetVersion),
+			Name:    pf.Name(),
+			Risk: &precondition.Risk{
+				Name:         "CrossMinorDowngrade",
+				MatchingRule: fmt.Sprintf("target %s is a different minor version than current %s", targetVersion, currentVersion),
+				Severity:     precondition.SeverityBlocking,
+			},
+		}
+	}
+
+	if previous := previousCompletedVersion(cv.Status.History); previous != "" && targetVersion.String() == previous {
+		klog.V(2).Infof("Precondition %s passed: retarget to %s is a rollback in progress to the previously completed version", pf.Name(), targetVersion)
+		return nil
+	}
+
+	if !rollbackAllowed(cv) {
+		return &precondition.Error{
+			Reason:  "RollbackNotAllowed",
+			Message: fmt.Sprintf("Downgrading from %s to %s requires rollback to be allowed; set the %s condition or the %s annotation", currentVersion, targetVersion, RollbackAllowedConditionType, RollbackAllowedAnnotation),
+			Name:    pf.Name(),
+			Risk: &precondition.Risk{
+				Name:         "RollbackNotAllowed",
+				MatchingRule: fmt.Sprintf("target %s is a patch-level downgrade from %s and rollback is not allowed", targetVersion, currentVersion),
+				Severity:     precondition.SeverityBlocking,
+			},
+		}
+	}
+
+	klog.V(2).Infof("Precondition %s passed: patch-level downgrade from %s to %s is allowed", pf.Name(), currentVersion, targetVersion)
+	return nil
+}
+
+// previousCompletedVersion returns the version of the completed update that precedes the current
+// completed update in history, i.e. the version a rollback-in-progress would be targeting, or the
+// empty string if there is no such entry.
+func previousCompletedVersion(history []configv1.UpdateHistory) string {
+	seenCurrent := false
+	for _, h := range history {
+		if h.State != configv1.CompletedUpdate {
+			continue
+		}
+		if seenCurrent {
+			return h.Version
+		}
+		seenCurrent = true
+	}
+	return ""
+}
+
+// Name returns Name for the precondition.
+func (pf *Rollback) Name() string { return "ClusterVersionRollback" }
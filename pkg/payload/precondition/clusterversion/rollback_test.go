@@ -0,0 +1,117 @@
+package clusterversion
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/cluster-version-operator/pkg/payload/precondition"
+)
+
+func TestRollbackRun(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		history         []configv1.UpdateHistory
+		desiredVersion  string
+		rollbackAllowed bool
+		expectedReason  string
+		expectBlocking  bool
+	}{
+		{
+			name: "equal version is not a rollback",
+			history: []configv1.UpdateHistory{
+				{Version: "4.14.15", State: configv1.CompletedUpdate},
+			},
+			desiredVersion: "4.14.15",
+		},
+		{
+			name: "patch downgrade without Rollback allowed is blocked",
+			history: []configv1.UpdateHistory{
+				{Version: "4.14.15", State: configv1.CompletedUpdate},
+			},
+			desiredVersion: "4.14.10",
+			expectedReason: "RollbackNotAllowed",
+			expectBlocking: true,
+		},
+		{
+			name: "patch downgrade with Rollback allowed passes",
+			history: []configv1.UpdateHistory{
+				{Version: "4.14.15", State: configv1.CompletedUpdate},
+			},
+			desiredVersion:  "4.14.10",
+			rollbackAllowed: true,
+		},
+		{
+			name: "minor downgrade is blocked regardless of Rollback allowed",
+			history: []configv1.UpdateHistory{
+				{Version: "4.14.15", State: configv1.CompletedUpdate},
+			},
+			desiredVersion:  "4.13.20",
+			rollbackAllowed: true,
+			expectedReason:  "CrossMinorDowngrade",
+			expectBlocking:  true,
+		},
+		{
+			name: "retarget to the previously completed version is a rollback in progress",
+			history: []configv1.UpdateHistory{
+				{Version: "4.14.15", State: configv1.CompletedUpdate},
+				{Version: "4.14.10", State: configv1.CompletedUpdate},
+			},
+			desiredVersion: "4.14.10",
+		},
+		{
+			name: "cross-minor downgrade to the previously completed version is still blocked",
+			history: []configv1.UpdateHistory{
+				{Version: "4.15.2", State: configv1.CompletedUpdate},
+				{Version: "4.14.10", State: configv1.CompletedUpdate},
+			},
+			desiredVersion:  "4.14.10",
+			rollbackAllowed: true,
+			expectedReason:  "CrossMinorDowngrade",
+			expectBlocking:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clusterVersion := &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: "version"},
+				Status: configv1.ClusterVersionStatus{
+					Desired: configv1.Release{Version: tc.history[0].Version},
+					History: tc.history,
+				},
+			}
+			if tc.rollbackAllowed {
+				clusterVersion.Status.Conditions = append(clusterVersion.Status.Conditions, configv1.ClusterOperatorStatusCondition{
+					Type:   RollbackAllowedConditionType,
+					Status: configv1.ConditionTrue,
+				})
+			}
+
+			instance := NewRollback(fakeClusterVersionLister(t, clusterVersion))
+			err := instance.Run(ctx, precondition.ReleaseContext{DesiredVersion: tc.desiredVersion})
+
+			if tc.expectedReason == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			pErr, ok := err.(*precondition.Error)
+			if !ok {
+				t.Fatalf("expected a *precondition.Error, got %v", err)
+			}
+			if pErr.Reason != tc.expectedReason {
+				t.Errorf("expected Reason %s, got %s", tc.expectedReason, pErr.Reason)
+			}
+			if pErr.Blocking() != tc.expectBlocking {
+				t.Errorf("expected Blocking()=%v, got %v", tc.expectBlocking, pErr.Blocking())
+			}
+		})
+	}
+}
@@ -3,17 +3,17 @@ package clusterversion
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
 	configv1 "github.com/openshift/api/config/v1"
 	configv1listers "github.com/openshift/client-go/config/listers/config/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/klog/v2"
 
 	"github.com/openshift/cluster-version-operator/lib/resourcemerge"
 	"github.com/openshift/cluster-version-operator/pkg/payload/precondition"
+	"github.com/openshift/cluster-version-operator/pkg/payload/upgradeplan"
 )
 
 // Upgradeable checks if clusterversion is upgradeable currently.
@@ -30,6 +30,12 @@ func NewUpgradeable(lister configv1listers.ClusterVersionLister) *Upgradeable {
 	}
 }
 
+func init() {
+	precondition.DefaultRegistry.Register("ClusterVersionUpgradeable", func(deps precondition.Dependencies) precondition.Check {
+		return NewUpgradeable(deps.ClusterVersionLister)
+	})
+}
+
 // ClusterVersionOverridesCondition returns an UpgradeableClusterVersionOverrides condition when overrides are set, and nil when no overrides are set.
 func ClusterVersionOverridesCondition(cv *configv1.ClusterVersion) *configv1.ClusterOperatorStatusCondition {
 	for _, override := range cv.Spec.Overrides {
@@ -50,17 +56,19 @@ func ClusterVersionOverridesCondition(cv *configv1.ClusterVersion) *configv1.Clu
 // If the feature gate `key` is not found, or the api for clusterversion doesn't exist, this check is inert and always returns nil error.
 // Otherwise, if Upgradeable condition is set to false in the object, it returns an PreconditionError when possible.
 func (pf *Upgradeable) Run(ctx context.Context, releaseContext precondition.ReleaseContext) error {
-	cv, err := pf.lister.Get(pf.key)
-	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+	cv, currentVersion, targetVersion, err := resolveVersions(pf.lister, pf.key, pf.Name(), releaseContext)
+	if err != nil {
+		return err
+	}
+	if cv == nil {
 		return nil
 	}
-	if err != nil {
-		return &precondition.Error{
-			Nested:  err,
-			Reason:  "UnknownError",
-			Message: err.Error(),
-			Name:    pf.Name(),
-		}
+
+	// Multi-minor jumps require passing through each intermediate minor's release, regardless of
+	// the Upgradeable condition's status: Upgradeable governs whether CVO trusts the cluster to
+	// move at all, not how many minors a single proposed target may skip.
+	if hopErr := multiMinorHopError(pf.Name(), cv, currentVersion, targetVersion); hopErr != nil {
+		return hopErr
 	}
 
 	// if we are upgradeable==true we can always upgrade
@@ -74,27 +82,6 @@ func (pf *Upgradeable) Run(ctx context.Context, releaseContext precondition.Rele
 		return nil
 	}
 
-	currentVersion, err := semver.Parse(cv.Status.Desired.Version)
-	if err != nil {
-		return &precondition.Error{
-			Nested:             err,
-			Reason:             "InvalidCurrentVersion",
-			Message:            err.Error(),
-			Name:               pf.Name(),
-			NonBlockingWarning: true, // do not block on issues that require an update to fix
-		}
-	}
-
-	targetVersion, err := semver.Parse(releaseContext.DesiredVersion)
-	if err != nil {
-		return &precondition.Error{
-			Nested:  err,
-			Reason:  "InvalidDesiredVersion",
-			Message: err.Error(),
-			Name:    pf.Name(),
-		}
-	}
-
 	klog.V(4).Infof("The current version is %s parsed from %s and the target version is %s parsed from %s", currentVersion.String(), cv.Status.Desired.Version, targetVersion.String(), releaseContext.DesiredVersion)
 	patchOnly := targetVersion.Major == currentVersion.Major && targetVersion.Minor == currentVersion.Minor
 	if targetVersion.LTE(currentVersion) || patchOnly {
@@ -106,6 +93,11 @@ func (pf *Upgradeable) Run(ctx context.Context, releaseContext precondition.Rele
 				Reason:  condition.Reason,
 				Message: condition.Message,
 				Name:    pf.Name(),
+				Risk: &precondition.Risk{
+					Name:         condition.Reason,
+					MatchingRule: "cv.Spec.Overrides contains an Unmanaged override",
+					Severity:     precondition.SeverityBlocking,
+				},
 			}
 		} else {
 			if completedVersion := minorUpdateFrom(cv.Status, currentVersion); completedVersion != "" && patchOnly {
@@ -115,6 +107,11 @@ func (pf *Upgradeable) Run(ctx context.Context, releaseContext precondition.Rele
 					Message:            fmt.Sprintf("Retarget to %s while a minor level upgrade from %s to %s is in progress", targetVersion, completedVersion, targetVersion),
 					Name:               pf.Name(),
 					NonBlockingWarning: true,
+					Risk: &precondition.Risk{
+						Name:         "MinorVersionClusterUpgradeInProgress",
+						MatchingRule: fmt.Sprintf("a minor level upgrade from %s to %s is in progress and the retarget to %s is patch-only", completedVersion, targetVersion, targetVersion),
+						Severity:     precondition.SeverityNonBlockingWarning,
+					},
 				}
 			}
 			klog.V(2).Infof("Precondition %q passed on update to %s", pf.Name(), targetVersion.String())
@@ -123,11 +120,85 @@ func (pf *Upgradeable) Run(ctx context.Context, releaseContext precondition.Rele
 	}
 
 	return &precondition.Error{
-		Nested:  err,
 		Reason:  up.Reason,
 		Message: up.Message,
 		Name:    pf.Name(),
+		Risk: &precondition.Risk{
+			Name:         up.Reason,
+			MatchingRule: "Upgradeable condition is False and the proposed update is neither patch-only nor a downgrade",
+			Severity:     precondition.SeverityBlocking,
+		},
+	}
+}
+
+// multiMinorHopError returns a blocking *precondition.Error when updating from cv's completed
+// version to targetVersion would skip one or more intermediate minor releases, naming the hops the
+// admin must pass through first. It returns nil when the proposed update is not a multi-minor jump.
+func multiMinorHopError(name string, cv *configv1.ClusterVersion, currentVersion, targetVersion semver.Version) *precondition.Error {
+	completedVersion := currentVersion
+	if completedVersionStr := GetCurrentVersion(cv.Status.History); completedVersionStr != "" {
+		if v, err := semver.Parse(completedVersionStr); err == nil {
+			completedVersion = v
+		}
 	}
+
+	plan := upgradeplan.New(completedVersion, targetVersion, cv.Status.AvailableUpdates)
+	if plan.Kind != upgradeplan.MultiMinor {
+		return nil
+	}
+
+	hops := make([]string, 0, len(plan.Hops))
+	for _, hop := range plan.Hops {
+		hops = append(hops, hop.Version)
+	}
+
+	// A multi-minor jump is blocked even when none of its intermediate minors could be resolved
+	// from AvailableUpdates: the jump is still disallowed, we just cannot name the hops yet.
+	message := fmt.Sprintf("Updating to %s must pass through %s", targetVersion, strings.Join(hops, ", "))
+	if len(hops) == 0 {
+		message = fmt.Sprintf("Updating to %s skips one or more intermediate minor versions, but the intermediate minor releases are not yet known from AvailableUpdates", targetVersion)
+	}
+
+	klog.V(2).Infof("Precondition %s: planned path is %s", name, plan.String())
+	return &precondition.Error{
+		Reason:  "MultiMinorUpgradeRequiresIntermediateHops",
+		Message: message,
+		Name:    name,
+		Risk: &precondition.Risk{
+			Name:         "MultiMinorUpgradeRequiresIntermediateHops",
+			MatchingRule: fmt.Sprintf("target %s is more than one minor ahead of %s", targetVersion, completedVersion),
+			Severity:     precondition.SeverityBlocking,
+		},
+	}
+}
+
+// resolveDesiredVersion parses desiredVersion as a semantic version. If desiredVersion is not a
+// semantic version, it is treated as a container image pullspec and looked up by Image against
+// cv.Status.AvailableUpdates and cv.Status.ConditionalUpdates, so that a `spec.desiredUpdate.image`
+// digest resolves to the Release it names. The returned bool is false when desiredVersion is
+// neither a semantic version nor a pullspec present in either list.
+func resolveDesiredVersion(cv *configv1.ClusterVersion, desiredVersion string) (semver.Version, bool) {
+	if v, err := semver.Parse(desiredVersion); err == nil {
+		return v, true
+	}
+
+	for _, update := range cv.Status.AvailableUpdates {
+		if update.Image == desiredVersion {
+			if v, err := semver.Parse(update.Version); err == nil {
+				return v, true
+			}
+		}
+	}
+
+	for _, conditional := range cv.Status.ConditionalUpdates {
+		if conditional.Release.Image == desiredVersion {
+			if v, err := semver.Parse(conditional.Release.Version); err == nil {
+				return v, true
+			}
+		}
+	}
+
+	return semver.Version{}, false
 }
 
 // minorUpdateFrom returns the version that was installed completed if a minor level upgrade is in progress
@@ -53,6 +53,158 @@ func TestGetEffectiveMinor(t *testing.T) {
 	}
 }
 
+func TestResolveDesiredVersion(t *testing.T) {
+	cv := &configv1.ClusterVersion{
+		Status: configv1.ClusterVersionStatus{
+			AvailableUpdates: []configv1.Release{
+				{Version: "4.2.1", Image: "quay.io/openshift-release-dev/ocp-release@sha256:available"},
+			},
+			ConditionalUpdates: []configv1.ConditionalUpdate{
+				{Release: configv1.Release{Version: "4.2.2", Image: "quay.io/openshift-release-dev/ocp-release@sha256:conditional"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		desiredVersion string
+		expected       string
+		expectedOK     bool
+	}{
+		{
+			name:           "semver",
+			desiredVersion: "4.2.0",
+			expected:       "4.2.0",
+			expectedOK:     true,
+		},
+		{
+			name:           "pullspec in AvailableUpdates",
+			desiredVersion: "quay.io/openshift-release-dev/ocp-release@sha256:available",
+			expected:       "4.2.1",
+			expectedOK:     true,
+		},
+		{
+			name:           "pullspec in ConditionalUpdates",
+			desiredVersion: "quay.io/openshift-release-dev/ocp-release@sha256:conditional",
+			expected:       "4.2.2",
+			expectedOK:     true,
+		},
+		{
+			name:           "pullspec not found anywhere",
+			desiredVersion: "quay.io/openshift-release-dev/ocp-release@sha256:unknown",
+			expectedOK:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := resolveDesiredVersion(cv, tc.desiredVersion)
+			if ok != tc.expectedOK {
+				t.Fatalf("expected ok=%v, got ok=%v", tc.expectedOK, ok)
+			}
+			if ok && actual.String() != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, actual.String())
+			}
+		})
+	}
+}
+
+func TestUpgradeableRunMultiMinorRequiresHops(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		conditions []configv1.ClusterOperatorStatusCondition
+	}{
+		{
+			name: "no Upgradeable condition at all",
+		},
+		{
+			name: "Upgradeable=True, the common healthy-cluster case",
+			conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorUpgradeable, Status: configv1.ConditionTrue},
+			},
+		},
+		{
+			name: "Upgradeable=False",
+			conditions: []configv1.ClusterOperatorStatusCondition{
+				{Type: configv1.OperatorUpgradeable, Status: configv1.ConditionFalse, Reason: "SomeReason", Message: "some message"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clusterVersion := &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: "version"},
+				Status: configv1.ClusterVersionStatus{
+					Desired:    configv1.Release{Version: "4.14.15"},
+					Conditions: tc.conditions,
+					History: []configv1.UpdateHistory{
+						{Version: "4.14.15", State: configv1.CompletedUpdate},
+					},
+					AvailableUpdates: []configv1.Release{
+						{Version: "4.15.20"},
+						{Version: "4.16.10"},
+					},
+				},
+			}
+
+			instance := NewUpgradeable(fakeClusterVersionLister(t, clusterVersion))
+			err := instance.Run(ctx, precondition.ReleaseContext{DesiredVersion: "4.17.3"})
+			pErr, ok := err.(*precondition.Error)
+			if !ok {
+				t.Fatalf("expected a *precondition.Error, got %v", err)
+			}
+			if pErr.Reason != "MultiMinorUpgradeRequiresIntermediateHops" {
+				t.Errorf("expected Reason MultiMinorUpgradeRequiresIntermediateHops, got %s", pErr.Reason)
+			}
+			if pErr.Risk == nil || pErr.Risk.Severity != precondition.SeverityBlocking {
+				t.Errorf("expected a blocking Risk, got %v", pErr.Risk)
+			}
+			expectedMessage := "Updating to 4.17.3 must pass through 4.15.20, 4.16.10"
+			if pErr.Message != expectedMessage {
+				t.Errorf("expected message %q, got %q", expectedMessage, pErr.Message)
+			}
+		})
+	}
+}
+
+func TestUpgradeableRunMultiMinorWithNoResolvableHops(t *testing.T) {
+	ctx := context.Background()
+	clusterVersion := &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "version"},
+		Status: configv1.ClusterVersionStatus{
+			Desired: configv1.Release{Version: "4.16.9"},
+			History: []configv1.UpdateHistory{
+				{Version: "4.16.9", State: configv1.CompletedUpdate},
+			},
+			// No 4.17.x entry: the only minor between 4.16 and 4.18.
+			AvailableUpdates: []configv1.Release{
+				{Version: "4.16.15"},
+				{Version: "4.18.2"},
+			},
+		},
+	}
+
+	instance := NewUpgradeable(fakeClusterVersionLister(t, clusterVersion))
+	err := instance.Run(ctx, precondition.ReleaseContext{DesiredVersion: "4.18.2"})
+	pErr, ok := err.(*precondition.Error)
+	if !ok {
+		t.Fatalf("expected a *precondition.Error, got %v", err)
+	}
+	if pErr.Reason != "MultiMinorUpgradeRequiresIntermediateHops" {
+		t.Errorf("expected Reason MultiMinorUpgradeRequiresIntermediateHops, got %s", pErr.Reason)
+	}
+	if pErr.Risk == nil || pErr.Risk.Severity != precondition.SeverityBlocking {
+		t.Errorf("expected a blocking Risk even with no hops named, got %v", pErr.Risk)
+	}
+	expectedMessage := "Updating to 4.18.2 skips one or more intermediate minor versions, but the intermediate minor releases are not yet known from AvailableUpdates"
+	if pErr.Message != expectedMessage {
+		t.Errorf("expected message %q, got %q", expectedMessage, pErr.Message)
+	}
+}
+
 func TestUpgradeableRun(t *testing.T) {
 	ctx := context.Background()
 	ptr := func(status configv1.ConditionStatus) *configv1.ConditionStatus {
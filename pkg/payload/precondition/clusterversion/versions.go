@@ -0,0 +1,76 @@
+package clusterversion
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	configv1listers "github.com/openshift/client-go/config/listers/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/klog/v2"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/openshift/cluster-version-operator/pkg/payload/precondition"
+)
+
+// resolveVersions fetches the ClusterVersion singleton via lister and resolves both the cluster's
+// current version and the proposed target version from releaseContext. Upgradeable and Rollback
+// both need exactly this before they can diverge into their own direction-specific checks, so they
+// share it here rather than keeping their own copies in sync by hand.
+//
+// A nil cv with a nil err means the ClusterVersion API is absent (e.g. NotFound on a cluster that
+// predates it); callers should treat that as an inert pass, same as lister.Get itself would.
+func resolveVersions(lister configv1listers.ClusterVersionLister, key, name string, releaseContext precondition.ReleaseContext) (cv *configv1.ClusterVersion, currentVersion, targetVersion semver.Version, err *precondition.Error) {
+	cv, getErr := lister.Get(key)
+	if apierrors.IsNotFound(getErr) || meta.IsNoMatchError(getErr) {
+		return nil, semver.Version{}, semver.Version{}, nil
+	}
+	if getErr != nil {
+		return nil, semver.Version{}, semver.Version{}, &precondition.Error{
+			Nested:  getErr,
+			Reason:  "UnknownError",
+			Message: getErr.Error(),
+			Name:    name,
+			Risk: &precondition.Risk{
+				Name:         "UnknownError",
+				MatchingRule: "lister.Get(\"version\") returned an error other than NotFound",
+				Severity:     precondition.SeverityBlocking,
+			},
+		}
+	}
+
+	currentVersion, parseErr := semver.Parse(cv.Status.Desired.Version)
+	if parseErr != nil {
+		return cv, semver.Version{}, semver.Version{}, &precondition.Error{
+			Nested:             parseErr,
+			Reason:             "InvalidCurrentVersion",
+			Message:            parseErr.Error(),
+			Name:               name,
+			NonBlockingWarning: true, // do not block on issues that require an update to fix
+			Risk: &precondition.Risk{
+				Name:         "InvalidCurrentVersion",
+				MatchingRule: "cv.Status.Desired.Version is not a semantic version",
+				Severity:     precondition.SeverityNonBlockingWarning,
+			},
+		}
+	}
+
+	targetVersion, ok := resolveDesiredVersion(cv, releaseContext.DesiredVersion)
+	if !ok {
+		klog.V(2).Infof("Precondition %s: desired version %s is neither a semantic version nor found in AvailableUpdates or ConditionalUpdates", name, releaseContext.DesiredVersion)
+		return cv, currentVersion, semver.Version{}, &precondition.Error{
+			Reason:             "UnknownDesiredVersion",
+			Message:            fmt.Sprintf("the desired version %s could not be resolved to a semantic version, nor found in AvailableUpdates or ConditionalUpdates", releaseContext.DesiredVersion),
+			Name:               name,
+			NonBlockingWarning: true,
+			Risk: &precondition.Risk{
+				Name:         "UnknownDesiredVersion",
+				MatchingRule: "releaseContext.DesiredVersion is not a semantic version and is not present in AvailableUpdates or ConditionalUpdates",
+				Severity:     precondition.SeverityNonBlockingWarning,
+			},
+		}
+	}
+
+	return cv, currentVersion, targetVersion, nil
+}
@@ -0,0 +1,64 @@
+package precondition
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	resultPass               = "pass"
+	resultNonBlockingWarning = "non_blocking_warning"
+	resultBlocking           = "blocking"
+	resultError              = "error"
+)
+
+// resultCode maps a result string to the value cvoPreconditionLastResult reports for it, so the
+// gauge can be read and alerted on without joining against the evaluations counter.
+var resultCode = map[string]float64{
+	resultPass:               0,
+	resultNonBlockingWarning: 1,
+	resultBlocking:           2,
+	resultError:              3,
+}
+
+var (
+	cvoPreconditionEvaluationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cvo_precondition_evaluations_total",
+		Help: "Counts precondition check evaluations, by check name and result (pass, non_blocking_warning, blocking, or error).",
+	}, []string{"name", "result"})
+
+	cvoPreconditionLastResult = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cvo_precondition_last_result",
+		Help: "The result of the most recent evaluation of a precondition check, by check name: 0=pass, 1=non_blocking_warning, 2=blocking, 3=error.",
+	}, []string{"name"})
+
+	cvoPreconditionEvaluationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "cvo_precondition_evaluation_duration_seconds",
+		Help:    "Time taken to evaluate a precondition check, by check name.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"name"})
+)
+
+// resultOf classifies the error returned by a Check's Run into one of the result label values.
+func resultOf(err error) string {
+	if err == nil {
+		return resultPass
+	}
+	if pErr, ok := err.(*Error); ok {
+		if pErr.Blocking() {
+			return resultBlocking
+		}
+		return resultNonBlockingWarning
+	}
+	return resultError
+}
+
+// observeResult records result as the outcome of evaluating the named check, taking duration.
+func observeResult(name string, err error, duration time.Duration) {
+	result := resultOf(err)
+	cvoPreconditionEvaluationsTotal.WithLabelValues(name, result).Inc()
+	cvoPreconditionLastResult.WithLabelValues(name).Set(resultCode[result])
+	cvoPreconditionEvaluationDuration.WithLabelValues(name).Observe(duration.Seconds())
+}
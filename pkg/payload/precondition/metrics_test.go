@@ -0,0 +1,59 @@
+package precondition
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/component-base/metrics/testutil"
+)
+
+func TestPreconditionMetricsLint(t *testing.T) {
+	problems, err := testutil.GatherAndLint(prometheus.DefaultGatherer,
+		"cvo_precondition_evaluations_total",
+		"cvo_precondition_last_result",
+		"cvo_precondition_evaluation_duration_seconds",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range problems {
+		t.Errorf("%s: %s", p.Metric, p.Text)
+	}
+}
+
+type runAllCheck struct {
+	name string
+	err  error
+}
+
+func (c runAllCheck) Run(_ context.Context, _ ReleaseContext) error { return c.err }
+func (c runAllCheck) Name() string                                  { return c.name }
+
+func TestRunAllRecordsResult(t *testing.T) {
+	ctx := context.Background()
+	list := List{
+		runAllCheck{name: "PassCheck"},
+		runAllCheck{name: "BlockingCheck", err: &Error{Name: "BlockingCheck", Reason: "Blocked", Risk: &Risk{Severity: SeverityBlocking}}},
+		runAllCheck{name: "WarningCheck", err: &Error{Name: "WarningCheck", Reason: "Warned", NonBlockingWarning: true}},
+		runAllCheck{name: "ErrorCheck", err: errors.New("boom")},
+	}
+
+	list.RunAll(ctx, ReleaseContext{DesiredVersion: "4.2.0"})
+
+	for _, tc := range []struct {
+		name           string
+		expectedResult string
+	}{
+		{"PassCheck", resultPass},
+		{"BlockingCheck", resultBlocking},
+		{"WarningCheck", resultNonBlockingWarning},
+		{"ErrorCheck", resultError},
+	} {
+		got := testutil.ToFloat64(cvoPreconditionLastResult.WithLabelValues(tc.name))
+		if want := resultCode[tc.expectedResult]; got != want {
+			t.Errorf("%s: expected cvo_precondition_last_result=%v, got %v", tc.name, want, got)
+		}
+	}
+}
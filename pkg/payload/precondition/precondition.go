@@ -0,0 +1,87 @@
+// Package precondition defines the interface that update preconditions implement, along with the
+// shared types (ReleaseContext, Error, Risk) that preconditions use to describe why a proposed
+// update should or should not proceed.
+package precondition
+
+import (
+	"context"
+	"time"
+)
+
+// ReleaseContext holds information about the proposed release that preconditions evaluate against.
+type ReleaseContext struct {
+	// DesiredVersion is the semantic version, or container image pullspec, of the proposed release.
+	DesiredVersion string
+}
+
+// Check is implemented by each precondition. A Check inspects cluster state and the proposed
+// ReleaseContext and reports whether the update it is concerned with may proceed.
+type Check interface {
+	// Run executes the precondition. A nil error means the precondition passed. A non-nil error is
+	// ordinarily an *Error so that callers can inspect Reason, Message, and Risk.
+	Run(ctx context.Context, releaseContext ReleaseContext) error
+	// Name returns an identifier for the precondition, used in logs, error reporting, and metrics.
+	Name() string
+}
+
+// List is an ordered set of preconditions to run together against a single proposed release.
+type List []Check
+
+// RunAll runs every precondition in the list against releaseContext and returns the errors
+// returned by each failing precondition, in list order. A nil returned slice means every
+// precondition in the list passed. Each check's outcome and evaluation latency are recorded to the
+// cvo_precondition_* metrics, keyed by the check's Name().
+func (l List) RunAll(ctx context.Context, releaseContext ReleaseContext) []error {
+	var errs []error
+	for _, check := range l {
+		start := time.Now()
+		err := check.Run(ctx, releaseContext)
+		observeResult(check.Name(), err, time.Since(start))
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// Error is returned by precondition Run methods to signal that a precondition has an opinion about
+// the proposed release. Whether that opinion blocks the update is carried by Risk.Severity when
+// Risk is set, and by NonBlockingWarning otherwise for preconditions that have not been migrated to
+// populate Risk.
+type Error struct {
+	// Nested holds the underlying error, if any, that caused this precondition to fire.
+	Nested error
+
+	// Reason is a CamelCase machine-readable reason, surfaced on the ClusterVersion Upgradeable
+	// condition or in the update status.
+	Reason string
+
+	// Message is a human-readable description of why the precondition fired.
+	Message string
+
+	// Name is the name of the precondition that produced this error, i.e. the Check's Name().
+	Name string
+
+	// NonBlockingWarning is true when this precondition should not block the update, only warn
+	// about it. Deprecated in favor of Risk.Severity; Blocking() reads it only when Risk is nil.
+	NonBlockingWarning bool
+
+	// Risk, when set, is the structured description of this finding. Preconditions reimplemented on
+	// top of the Registry populate this field, and still set NonBlockingWarning alongside it for
+	// callers that read that field directly.
+	Risk *Risk
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// Unwrap allows errors.Is and errors.As to reach Nested.
+func (e *Error) Unwrap() error { return e.Nested }
+
+// Blocking reports whether this error should block the proposed update. Preconditions that
+// populate Risk are governed by Risk.Severity; older preconditions fall back to NonBlockingWarning.
+func (e *Error) Blocking() bool {
+	if e.Risk != nil {
+		return e.Risk.Severity == SeverityBlocking
+	}
+	return !e.NonBlockingWarning
+}
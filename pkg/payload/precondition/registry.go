@@ -0,0 +1,64 @@
+package precondition
+
+import (
+	"sync"
+
+	configv1listers "github.com/openshift/client-go/config/listers/config/v1"
+)
+
+// Dependencies bundles the inputs that a Factory may need to construct a Check. CVO populates one
+// Dependencies at startup and passes it to every registered factory, so out-of-tree consumers do
+// not need to invent their own wiring to reach cluster state CVO already watches.
+type Dependencies struct {
+	// ClusterVersionLister allows a Check to read the current ClusterVersion.
+	ClusterVersionLister configv1listers.ClusterVersionLister
+}
+
+// Factory constructs a Check from the shared Dependencies. Out-of-tree consumers (HyperShift, ARO,
+// MCE) register a Factory under a unique name so their checks run alongside the checks CVO ships,
+// without patching CVO itself.
+type Factory func(deps Dependencies) Check
+
+// Registry collects named Check factories and instantiates them into a List.
+type Registry struct {
+	mu        sync.Mutex
+	factories map[string]Factory
+	order     []string
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{factories: map[string]Factory{}}
+}
+
+// Register adds factory under name, so Build will include it when instantiating the List.
+// Registering the same name twice replaces the earlier factory without changing its position in
+// registration order.
+func (r *Registry) Register(name string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.factories[name]; !exists {
+		r.order = append(r.order, name)
+	}
+	r.factories[name] = factory
+}
+
+// Build instantiates every registered factory with deps and returns the resulting checks as a
+// List, in registration order, so callers can enumerate every risk a proposed target would raise
+// rather than only the first blocking one.
+func (r *Registry) Build(deps Dependencies) List {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make(List, 0, len(r.order))
+	for _, name := range r.order {
+		list = append(list, r.factories[name](deps))
+	}
+	return list
+}
+
+// DefaultRegistry is the Registry that CVO's own preconditions, and any out-of-tree consumer
+// checks linked into the binary, register into via an init() in their own package. A caller gets
+// the List of every registered check by calling
+// DefaultRegistry.Build(Dependencies{ClusterVersionLister: ...}) once the shared listers are
+// available, instead of constructing each Check by hand.
+var DefaultRegistry = NewRegistry()
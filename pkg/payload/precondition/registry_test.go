@@ -0,0 +1,31 @@
+package precondition
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeCheck struct {
+	name string
+}
+
+func (f fakeCheck) Run(_ context.Context, _ ReleaseContext) error { return nil }
+func (f fakeCheck) Name() string                                  { return f.name }
+
+func TestRegistryBuild(t *testing.T) {
+	r := NewRegistry()
+	r.Register("second", func(Dependencies) Check { return fakeCheck{name: "second"} })
+	r.Register("first", func(Dependencies) Check { return fakeCheck{name: "first"} })
+	r.Register("second", func(Dependencies) Check { return fakeCheck{name: "second-replaced"} })
+
+	list := r.Build(Dependencies{})
+	if len(list) != 2 {
+		t.Fatalf("expected 2 checks, got %d", len(list))
+	}
+	if list[0].Name() != "second-replaced" {
+		t.Errorf("expected re-registering a name to keep its original position, got %q first", list[0].Name())
+	}
+	if list[1].Name() != "first" {
+		t.Errorf("expected \"first\" second, got %q", list[1].Name())
+	}
+}
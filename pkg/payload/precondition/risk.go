@@ -0,0 +1,34 @@
+package precondition
+
+// Severity classifies how strongly a Risk should be acted upon.
+type Severity string
+
+const (
+	// SeverityBlocking means the update must not proceed while this risk applies.
+	SeverityBlocking Severity = "Blocking"
+
+	// SeverityNonBlockingWarning means the update may proceed, but the risk should be surfaced to
+	// the admin, e.g. via the Upgradeable condition or update status.
+	SeverityNonBlockingWarning Severity = "NonBlockingWarning"
+
+	// SeverityInfo means the finding is informational only and does not need to be surfaced as a
+	// warning.
+	SeverityInfo Severity = "Info"
+)
+
+// Risk is a structured, machine-readable description of a finding produced by a precondition
+// Check, modeled on the conditional update risks already carried on ClusterVersion status.
+type Risk struct {
+	// Name is a short CamelCase identifier for the risk, e.g. "MinorVersionClusterUpgradeInProgress".
+	Name string
+
+	// MatchingRule is an expression describing the cluster state that caused this risk to match,
+	// for display back to the admin alongside the risk.
+	MatchingRule string
+
+	// URL, when set, points at documentation describing the risk and how to resolve it.
+	URL string
+
+	// Severity classifies whether this risk should block the update.
+	Severity Severity
+}
@@ -0,0 +1,142 @@
+// Package upgradeplan classifies a proposed ClusterVersion update and, for updates that span more
+// than one minor version, enumerates the intermediate y-stream releases that must be visited along
+// the way.
+package upgradeplan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// Kind classifies the relationship between the current and the desired version of a proposed
+// update.
+type Kind string
+
+const (
+	// Patch is an update that keeps the same major.minor and only advances the patch level.
+	Patch Kind = "Patch"
+
+	// Minor is an update that advances exactly one minor version.
+	Minor Kind = "Minor"
+
+	// MultiMinor is an update that advances more than one minor version and therefore requires
+	// intermediate hops through each skipped minor.
+	MultiMinor Kind = "MultiMinor"
+
+	// Downgrade is an update whose desired version is lower than the current version.
+	Downgrade Kind = "Downgrade"
+
+	// Retarget is an update to the same version as current, e.g. re-asserting an update that is
+	// already in progress.
+	Retarget Kind = "Retarget"
+)
+
+// Hop is a single intermediate release a Plan requires the cluster to complete before continuing
+// on towards the final desired version.
+type Hop struct {
+	// Version is the target version of this hop.
+	Version string
+
+	// Image is the pullspec of this hop's release, resolved from ClusterVersion.Status.AvailableUpdates.
+	Image string
+}
+
+// Plan describes how a proposed update from a current version to a desired version should be
+// carried out.
+type Plan struct {
+	// Kind classifies the proposed update.
+	Kind Kind
+
+	// CurrentVersion is the version the plan was computed from.
+	CurrentVersion string
+
+	// DesiredVersion is the final version the update is headed for.
+	DesiredVersion string
+
+	// Hops is the ordered set of intermediate y-stream releases required before DesiredVersion can
+	// be requested directly. Empty unless Kind is MultiMinor.
+	Hops []Hop
+}
+
+// String renders the plan for logging, e.g. "MultiMinor 4.14.15 -> 4.15.20 -> 4.16.10 -> 4.17.3".
+func (p *Plan) String() string {
+	versions := append([]string{p.CurrentVersion}, hopVersions(p.Hops)...)
+	versions = append(versions, p.DesiredVersion)
+	return fmt.Sprintf("%s %s", p.Kind, strings.Join(versions, " -> "))
+}
+
+func hopVersions(hops []Hop) []string {
+	versions := make([]string, 0, len(hops))
+	for _, hop := range hops {
+		versions = append(versions, hop.Version)
+	}
+	return versions
+}
+
+// New classifies an update from currentVersion to targetVersion and, for multi-minor jumps,
+// resolves the intermediate hops from availableUpdates.
+func New(currentVersion, targetVersion semver.Version, availableUpdates []configv1.Release) *Plan {
+	plan := &Plan{
+		CurrentVersion: currentVersion.String(),
+		DesiredVersion: targetVersion.String(),
+	}
+
+	switch {
+	case targetVersion.EQ(currentVersion):
+		plan.Kind = Retarget
+	case targetVersion.LT(currentVersion):
+		plan.Kind = Downgrade
+	case targetVersion.Major == currentVersion.Major && targetVersion.Minor == currentVersion.Minor:
+		plan.Kind = Patch
+	case targetVersion.Major != currentVersion.Major || targetVersion.Minor-currentVersion.Minor > 1:
+		plan.Kind = MultiMinor
+		plan.Hops = intermediateHops(currentVersion, targetVersion, availableUpdates)
+	default:
+		plan.Kind = Minor
+	}
+
+	return plan
+}
+
+// intermediateHops returns one Hop per intermediate 4.y release strictly between currentVersion
+// and targetVersion, using the latest patch of each intermediate minor found in availableUpdates.
+// A minor with no matching entry in availableUpdates is omitted, since the plan can only recommend
+// hops that CVO already knows an available update to.
+func intermediateHops(currentVersion, targetVersion semver.Version, availableUpdates []configv1.Release) []Hop {
+	if currentVersion.Major != targetVersion.Major {
+		return nil
+	}
+
+	var hops []Hop
+	for minor := currentVersion.Minor + 1; minor < targetVersion.Minor; minor++ {
+		if hop, ok := latestPatchForMinor(currentVersion.Major, minor, availableUpdates); ok {
+			hops = append(hops, hop)
+		}
+	}
+	return hops
+}
+
+// latestPatchForMinor returns the highest-patch release in availableUpdates whose version is
+// major.minor, and true if one was found.
+func latestPatchForMinor(major, minor uint64, availableUpdates []configv1.Release) (Hop, bool) {
+	var best *semver.Version
+	var bestImage string
+	for _, update := range availableUpdates {
+		v, err := semver.Parse(update.Version)
+		if err != nil || v.Major != major || v.Minor != minor {
+			continue
+		}
+		if best == nil || v.GT(*best) {
+			vCopy := v
+			best = &vCopy
+			bestImage = update.Image
+		}
+	}
+	if best == nil {
+		return Hop{}, false
+	}
+	return Hop{Version: best.String(), Image: bestImage}, true
+}
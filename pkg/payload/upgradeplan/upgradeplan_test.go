@@ -0,0 +1,97 @@
+package upgradeplan
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func mustParse(t *testing.T, v string) semver.Version {
+	t.Helper()
+	parsed, err := semver.Parse(v)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", v, err)
+	}
+	return parsed
+}
+
+func TestNew(t *testing.T) {
+	availableUpdates := []configv1.Release{
+		{Version: "4.15.20", Image: "release-4.15.20"},
+		{Version: "4.15.30", Image: "release-4.15.30"},
+		{Version: "4.16.10", Image: "release-4.16.10"},
+	}
+
+	tests := []struct {
+		name           string
+		currentVersion string
+		targetVersion  string
+		expectedKind   Kind
+		expectedHops   []string
+	}{
+		{
+			name:           "patch",
+			currentVersion: "4.14.15",
+			targetVersion:  "4.14.20",
+			expectedKind:   Patch,
+		},
+		{
+			name:           "minor",
+			currentVersion: "4.14.15",
+			targetVersion:  "4.15.2",
+			expectedKind:   Minor,
+		},
+		{
+			name:           "retarget",
+			currentVersion: "4.14.15",
+			targetVersion:  "4.14.15",
+			expectedKind:   Retarget,
+		},
+		{
+			name:           "downgrade",
+			currentVersion: "4.14.15",
+			targetVersion:  "4.13.20",
+			expectedKind:   Downgrade,
+		},
+		{
+			name:           "multi-minor with resolvable hops",
+			currentVersion: "4.14.15",
+			targetVersion:  "4.17.3",
+			expectedKind:   MultiMinor,
+			expectedHops:   []string{"4.15.30", "4.16.10"},
+		},
+		{
+			name:           "multi-minor with an unresolvable hop",
+			currentVersion: "4.13.15",
+			targetVersion:  "4.17.3",
+			expectedKind:   MultiMinor,
+			expectedHops:   []string{"4.15.30", "4.16.10"},
+		},
+		{
+			name:           "multi-minor with no resolvable hops at all",
+			currentVersion: "4.16.9",
+			targetVersion:  "4.18.2",
+			expectedKind:   MultiMinor,
+			expectedHops:   nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := New(mustParse(t, tc.currentVersion), mustParse(t, tc.targetVersion), availableUpdates)
+			if plan.Kind != tc.expectedKind {
+				t.Errorf("expected Kind %s, got %s", tc.expectedKind, plan.Kind)
+			}
+			actualHops := hopVersions(plan.Hops)
+			if len(actualHops) != len(tc.expectedHops) {
+				t.Fatalf("expected hops %v, got %v", tc.expectedHops, actualHops)
+			}
+			for i, v := range tc.expectedHops {
+				if actualHops[i] != v {
+					t.Errorf("expected hop %d to be %s, got %s", i, v, actualHops[i])
+				}
+			}
+		})
+	}
+}